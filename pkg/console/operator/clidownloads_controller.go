@@ -0,0 +1,73 @@
+package operator
+
+import (
+	"context"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	consoleclidownloadinformersv1 "github.com/openshift/client-go/console/informers/externalversions/console/v1"
+	consoleclientv1 "github.com/openshift/client-go/console/clientset/versioned/typed/console/v1"
+
+	"github.com/openshift/console-operator/pkg/console/subresource/consoleclidownload"
+)
+
+const cliDownloadsConditionsPrefix = "CLIDownloads"
+const cliDownloadsFieldManager = "console-clidownloads-controller"
+
+// ConsoleCLIDownloadsController reconciles the ConsoleCLIDownload CRs that
+// advertise oc/kubectl binaries to users. It never touched the deployment,
+// route or OAuth client, so it no longer needs to share a Sync with those.
+type ConsoleCLIDownloadsController struct {
+	operatorClient v1helpers.OperatorClient
+	cliDownloads   consoleclientv1.ConsoleCLIDownloadsGetter
+}
+
+func NewConsoleCLIDownloadsController(
+	operatorClient v1helpers.OperatorClient,
+	cliDownloads consoleclientv1.ConsoleCLIDownloadsGetter,
+	cliDownloadInformer consoleclidownloadinformersv1.ConsoleCLIDownloadInformer,
+	recorder events.Recorder,
+) factory.Controller {
+	c := &ConsoleCLIDownloadsController{
+		operatorClient: operatorClient,
+		cliDownloads:   cliDownloads,
+	}
+
+	return factory.New().
+		WithFilteredEventsInformers(namesFilter(consoleclidownload.OCCLIDownloadsCRName()), cliDownloadInformer.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsoleCLIDownloadsController", recorder.WithComponentSuffix("console-clidownloads-controller"))
+}
+
+func (c *ConsoleCLIDownloadsController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	if err := consoleclidownload.ApplyOCCLIDownloads(ctx, c.cliDownloads, controllerContext.Recorder()); err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "CLIDownloadsApplyFailed", err)
+	}
+
+	return applyStatus(ctx, c.operatorClient, cliDownloadsFieldManager, operatorStatus.ObservedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType(cliDownloadsConditionsPrefix+"Available").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason("AsExpected"),
+		applyoperatorv1.OperatorCondition().
+			WithType(cliDownloadsConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+}
+
+func (c *ConsoleCLIDownloadsController) degraded(ctx context.Context, observedGeneration int64, reason string, err error) error {
+	return degradedStatus(ctx, c.operatorClient, cliDownloadsFieldManager, cliDownloadsConditionsPrefix, observedGeneration, reason, err)
+}
@@ -0,0 +1,185 @@
+package operator
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorsv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/console-operator/pkg/api"
+)
+
+// configMissingError marks an error returned by configSetGetter.ConfigSet as
+// "this config genuinely does not exist", as opposed to a transient
+// apiserver error encountered while trying to find out. Controllers use
+// isConfigMissing to decide whether to surface Degraded=ConfigMissing or to
+// return the error untouched so factory retries with backoff instead of
+// reporting a condition that isn't actually true.
+type configMissingError struct {
+	err error
+}
+
+func (e *configMissingError) Error() string { return e.err.Error() }
+func (e *configMissingError) Unwrap() error { return e.err }
+
+func isConfigMissing(err error) bool {
+	_, ok := err.(*configMissingError)
+	return ok
+}
+
+// configGetBackoff bounds the retry of a single config Get. It is small and
+// fast because ConfigSet is called on every controller's Sync; a config that
+// is still unavailable after these retries should fail the Sync and let
+// factory's own requeue backoff take over rather than blocking one
+// reconcile attempt indefinitely.
+var configGetBackoff = wait.Backoff{
+	Duration: 100 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+	Steps:    5,
+}
+
+// isRetriableConfigError reports whether err looks like a transient
+// apiserver or network blip worth retrying, rather than a real NotFound or
+// a permanent failure like Forbidden.
+func isRetriableConfigError(err error) bool {
+	if errors.IsServerTimeout(err) || errors.IsTooManyRequests(err) || errors.IsInternalError(err) {
+		return true
+	}
+	return stderrors.As(err, new(*net.OpError))
+}
+
+// getWithRetry runs getFn, retrying with configGetBackoff while the error
+// looks transient per isRetriableConfigError, and returns the last error
+// unwrapped otherwise.
+func getWithRetry(ctx context.Context, getFn func(ctx context.Context) error) error {
+	var lastErr error
+	waitErr := wait.ExponentialBackoffWithContext(ctx, configGetBackoff, func(ctx context.Context) (bool, error) {
+		lastErr = getFn(ctx)
+		if lastErr == nil {
+			return true, nil
+		}
+		if isRetriableConfigError(lastErr) {
+			return false, nil
+		}
+		return false, lastErr
+	})
+	if waitErr == wait.ErrWaitTimeout {
+		return lastErr
+	}
+	return waitErr
+}
+
+// getOperatorConfig returns the operator's Console config, reading the
+// informer cache first and only falling back to a live, retried Get on a
+// cache miss.
+func (g *configSetGetter) getOperatorConfig(ctx context.Context) (*operatorsv1.Console, error) {
+	if cfg, err := g.operatorConfigLister.Get(api.ConfigResourceName); err == nil {
+		return cfg, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	var cfg *operatorsv1.Console
+	err := getWithRetry(ctx, func(ctx context.Context) error {
+		var getErr error
+		cfg, getErr = g.operatorConfigClient.Get(ctx, api.ConfigResourceName, metav1.GetOptions{})
+		return getErr
+	})
+	if errors.IsNotFound(err) {
+		return nil, &configMissingError{err: err}
+	}
+	return cfg, err
+}
+
+// getConsoleConfig returns the cluster Console config, reading the informer
+// cache first and only falling back to a live, retried Get on a cache miss.
+func (g *configSetGetter) getConsoleConfig(ctx context.Context) (*configv1.Console, error) {
+	if cfg, err := g.consoleConfigLister.Get(api.ConfigResourceName); err == nil {
+		return cfg, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	var cfg *configv1.Console
+	err := getWithRetry(ctx, func(ctx context.Context) error {
+		var getErr error
+		cfg, getErr = g.configClient.Consoles().Get(ctx, api.ConfigResourceName, metav1.GetOptions{})
+		return getErr
+	})
+	if errors.IsNotFound(err) {
+		return nil, &configMissingError{err: err}
+	}
+	return cfg, err
+}
+
+// getInfrastructureConfig returns the cluster Infrastructure config, reading
+// the informer cache first and only falling back to a live, retried Get on a
+// cache miss.
+func (g *configSetGetter) getInfrastructureConfig(ctx context.Context) (*configv1.Infrastructure, error) {
+	if cfg, err := g.infrastructureLister.Get(api.ConfigResourceName); err == nil {
+		return cfg, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	var cfg *configv1.Infrastructure
+	err := getWithRetry(ctx, func(ctx context.Context) error {
+		var getErr error
+		cfg, getErr = g.configClient.Infrastructures().Get(ctx, api.ConfigResourceName, metav1.GetOptions{})
+		return getErr
+	})
+	if errors.IsNotFound(err) {
+		return nil, &configMissingError{err: err}
+	}
+	return cfg, err
+}
+
+// getProxyConfig returns the cluster Proxy config, reading the informer
+// cache first and only falling back to a live, retried Get on a cache miss.
+func (g *configSetGetter) getProxyConfig(ctx context.Context) (*configv1.Proxy, error) {
+	if cfg, err := g.proxyLister.Get(api.ConfigResourceName); err == nil {
+		return cfg, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	var cfg *configv1.Proxy
+	err := getWithRetry(ctx, func(ctx context.Context) error {
+		var getErr error
+		cfg, getErr = g.configClient.Proxies().Get(ctx, api.ConfigResourceName, metav1.GetOptions{})
+		return getErr
+	})
+	if errors.IsNotFound(err) {
+		return nil, &configMissingError{err: err}
+	}
+	return cfg, err
+}
+
+// getOAuthConfig returns the cluster OAuth config, reading the informer
+// cache first and only falling back to a live, retried Get on a cache miss.
+func (g *configSetGetter) getOAuthConfig(ctx context.Context) (*configv1.OAuth, error) {
+	if cfg, err := g.oauthLister.Get(api.ConfigResourceName); err == nil {
+		return cfg, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	var cfg *configv1.OAuth
+	err := getWithRetry(ctx, func(ctx context.Context) error {
+		var getErr error
+		cfg, getErr = g.configClient.OAuths().Get(ctx, api.ConfigResourceName, metav1.GetOptions{})
+		return getErr
+	})
+	if errors.IsNotFound(err) {
+		return nil, &configMissingError{err: err}
+	}
+	return cfg, err
+}
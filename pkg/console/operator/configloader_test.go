@@ -0,0 +1,104 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestIsRetriableConfigError(t *testing.T) {
+	gr := schema.GroupResource{Resource: "consoles"}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"server timeout", apierrors.NewServerTimeout(gr, "get", 1), true},
+		{"too many requests", apierrors.NewTooManyRequests("busy", 1), true},
+		{"internal error", apierrors.NewInternalError(errors.New("boom")), true},
+		{"net op error", &net.OpError{Op: "dial", Err: errors.New("refused")}, true},
+		{"wrapped net op error", &url.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{Op: "dial", Err: errors.New("refused")}}, true},
+		{"not found", apierrors.NewNotFound(gr, "console"), false},
+		{"forbidden", apierrors.NewForbidden(gr, "console", errors.New("nope")), false},
+		{"plain error", errors.New("some other error"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetriableConfigError(tt.err); got != tt.want {
+				t.Errorf("isRetriableConfigError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetWithRetrySucceedsAfterTransientErrors(t *testing.T) {
+	defer withFastConfigGetBackoff()()
+
+	attempts := 0
+	err := getWithRetry(context.Background(), func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return apierrors.NewServerTimeout(schema.GroupResource{Resource: "consoles"}, "get", 1)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestGetWithRetryReturnsImmediatelyOnNonRetriableError(t *testing.T) {
+	defer withFastConfigGetBackoff()()
+
+	attempts := 0
+	wantErr := apierrors.NewNotFound(schema.GroupResource{Resource: "consoles"}, "console")
+	err := getWithRetry(context.Background(), func(ctx context.Context) error {
+		attempts++
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected exactly 1 attempt for a non-retriable error, got %d", attempts)
+	}
+}
+
+func TestGetWithRetryReturnsLastErrorAfterExhaustingBackoff(t *testing.T) {
+	defer withFastConfigGetBackoff()()
+
+	attempts := 0
+	err := getWithRetry(context.Background(), func(ctx context.Context) error {
+		attempts++
+		return apierrors.NewServerTimeout(schema.GroupResource{Resource: "consoles"}, "get", 1)
+	})
+	if err == nil {
+		t.Fatal("expected an error after exhausting the backoff")
+	}
+	if attempts != configGetBackoff.Steps {
+		t.Fatalf("expected %d attempts, got %d", configGetBackoff.Steps, attempts)
+	}
+}
+
+// withFastConfigGetBackoff shrinks configGetBackoff for the duration of a
+// test and returns a func to restore it, so these tests don't spend real
+// wall-clock time on the production backoff schedule.
+func withFastConfigGetBackoff() func() {
+	original := configGetBackoff
+	configGetBackoff = wait.Backoff{
+		Duration: time.Millisecond,
+		Factor:   1.0,
+		Steps:    3,
+	}
+	return func() { configGetBackoff = original }
+}
@@ -0,0 +1,130 @@
+package operator
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	consolepluginsinformersv1 "github.com/openshift/client-go/console/informers/externalversions/console/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	"github.com/openshift/console-operator/pkg/api"
+	"github.com/openshift/console-operator/pkg/console/subresource/configmap"
+)
+
+const configMapConditionsPrefix = "ConfigMap"
+const configMapFieldManager = "console-configmap-controller"
+
+// ConsoleConfigMapController reconciles the user-facing console-config
+// ConfigMap (console-config.yaml) in openshift-console. The service-CA,
+// trusted-CA, and public config ConfigMaps each get their own narrower
+// controller below since they change for different reasons and at
+// different rates.
+type ConsoleConfigMapController struct {
+	operatorClient  v1helpers.OperatorClient
+	configSetGetter *configSetGetter
+	configMapClient coreclientv1.ConfigMapsGetter
+	observedPlugins *pluginObservationCache
+	deleteState     deleteState
+}
+
+func NewConsoleConfigMapController(
+	operatorClient v1helpers.OperatorClient,
+	configSetGetter *configSetGetter,
+	configMapClient coreclientv1.ConfigMapsGetter,
+	configMaps corev1informers.ConfigMapInformer,
+	observedPlugins *pluginObservationCache,
+	consolePlugins consolepluginsinformersv1.ConsolePluginInformer,
+	services corev1informers.ServiceInformer,
+	recorder events.Recorder,
+) (factory.Controller, removableResource) {
+	c := &ConsoleConfigMapController{
+		operatorClient:  operatorClient,
+		configSetGetter: configSetGetter,
+		configMapClient: configMapClient,
+		observedPlugins: observedPlugins,
+	}
+
+	ctrl := factory.New().
+		WithFilteredEventsInformers(namesFilter(api.OpenShiftConsoleConfigMapName), configMaps.Informer()).
+		// Plugin and backing-Service changes don't touch the console-config
+		// ConfigMap directly, but they can change what observedPlugins.get()
+		// returns - without watching these too, a plugin resolving (or
+		// breaking) wouldn't trigger a resync until something else happened
+		// to touch console-config.
+		WithInformers(consolePlugins.Informer(), services.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsoleConfigMapController", recorder.WithComponentSuffix("console-configmap-controller"))
+	return ctrl, c
+}
+
+func (c *ConsoleConfigMapController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	configs, err := c.configSetGetter.ConfigSet(ctx)
+	if isConfigMissing(err) {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "ConfigMissing", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	// console-config.yaml's plugins section is written from the set of
+	// plugins ConsolePluginsController actually resolved a working backend
+	// for, not from spec - a plugin can be listed in spec without one.
+	operatorConfig := configs.Operator.DeepCopy()
+	operatorConfig.Spec.Plugins = c.observedPlugins.get()
+
+	required, err := configmap.DefaultConfigMap(operatorConfig, configs.Console, configs.Infrastructure, configs.OAuth)
+	if err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "ConfigMapBuildFailed", err)
+	}
+	if _, _, err := resourceapply.ApplyConfigMap(ctx, c.configMapClient, controllerContext.Recorder(), required); err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "ConfigMapApplyFailed", err)
+	}
+
+	return applyStatus(ctx, c.operatorClient, configMapFieldManager, operatorStatus.ObservedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType(configMapConditionsPrefix+"Available").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason("AsExpected"),
+		applyoperatorv1.OperatorCondition().
+			WithType(configMapConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+}
+
+func (c *ConsoleConfigMapController) degraded(ctx context.Context, observedGeneration int64, reason string, err error) error {
+	return degradedStatus(ctx, c.operatorClient, configMapFieldManager, configMapConditionsPrefix, observedGeneration, reason, err)
+}
+
+func (c *ConsoleConfigMapController) tombstone(ctx context.Context) (tombstoneResult, error) {
+	name := configmap.Stub().Name
+	outcome, err := requestDelete(ctx, funcObjectDeleter{
+		getFn: func(ctx context.Context) (metav1.Object, error) {
+			return c.configMapClient.ConfigMaps(api.TargetNamespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		annotate_: func(ctx context.Context, key, value string) error {
+			return annotateObject[*corev1.ConfigMap](ctx, c.configMapClient.ConfigMaps(api.TargetNamespace), name, key, value)
+		},
+		deleteFn: func(ctx context.Context) error {
+			return c.configMapClient.ConfigMaps(api.TargetNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+	}, &c.deleteState)
+	return tombstoneResult{Done: outcome == deleteComplete, Reappeared: outcome == deleteReappeared}, err
+}
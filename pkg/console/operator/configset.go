@@ -0,0 +1,97 @@
+package operator
+
+import (
+	"context"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	configclientv1 "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
+	configlistersv1 "github.com/openshift/client-go/config/listers/config/v1"
+	operatorclientv1 "github.com/openshift/client-go/operator/clientset/versioned/typed/operator/v1"
+	operatorlistersv1 "github.com/openshift/client-go/operator/listers/operator/v1"
+)
+
+// configSet is the bundle of top level configuration this operator reacts
+// to. It used to be assembled from five sequential live Gets in Sync; it is
+// now assembled from informer-backed listers so that a single slow or
+// missing config no longer aborts every controller's reconcile.
+type configSet struct {
+	Console        *configv1.Console
+	Operator       *operatorsv1.Console
+	Infrastructure *configv1.Infrastructure
+	Proxy          *configv1.Proxy
+	OAuth          *configv1.OAuth
+}
+
+// configSetGetter loads a configSet from the caches shared by every
+// per-resource controller, falling back to a retried live Get against
+// operatorConfigClient/configClient on a cache miss. Each controller calls
+// ConfigSet() at the start of its own Sync instead of issuing its own round
+// of Gets against the apiserver.
+type configSetGetter struct {
+	operatorConfigLister operatorlistersv1.ConsoleLister
+	consoleConfigLister  configlistersv1.ConsoleLister
+	infrastructureLister configlistersv1.InfrastructureLister
+	proxyLister          configlistersv1.ProxyLister
+	oauthLister          configlistersv1.OAuthLister
+
+	operatorConfigClient operatorclientv1.ConsoleInterface
+	configClient         configclientv1.ConfigV1Interface
+}
+
+func newConfigSetGetter(
+	operatorConfigLister operatorlistersv1.ConsoleLister,
+	consoleConfigLister configlistersv1.ConsoleLister,
+	infrastructureLister configlistersv1.InfrastructureLister,
+	proxyLister configlistersv1.ProxyLister,
+	oauthLister configlistersv1.OAuthLister,
+	operatorConfigClient operatorclientv1.ConsoleInterface,
+	configClient configclientv1.ConfigV1Interface,
+) *configSetGetter {
+	return &configSetGetter{
+		operatorConfigLister: operatorConfigLister,
+		consoleConfigLister:  consoleConfigLister,
+		infrastructureLister: infrastructureLister,
+		proxyLister:          proxyLister,
+		oauthLister:          oauthLister,
+		operatorConfigClient: operatorConfigClient,
+		configClient:         configClient,
+	}
+}
+
+// ConfigSet returns the current configSet, preferring the informer caches
+// and falling back to a backoff-retried live Get for any config missing
+// from cache. Callers should check isConfigMissing(err) to tell a config
+// that genuinely doesn't exist, which they should surface as
+// Degraded=ConfigMissing, apart from a transient apiserver error, which
+// they should return as-is so factory requeues with backoff.
+func (g *configSetGetter) ConfigSet(ctx context.Context) (configSet, error) {
+	operatorConfig, err := g.getOperatorConfig(ctx)
+	if err != nil {
+		return configSet{}, err
+	}
+	consoleConfig, err := g.getConsoleConfig(ctx)
+	if err != nil {
+		return configSet{}, err
+	}
+	infrastructureConfig, err := g.getInfrastructureConfig(ctx)
+	if err != nil {
+		return configSet{}, err
+	}
+	proxyConfig, err := g.getProxyConfig(ctx)
+	if err != nil {
+		return configSet{}, err
+	}
+	oauthConfig, err := g.getOAuthConfig(ctx)
+	if err != nil {
+		return configSet{}, err
+	}
+
+	return configSet{
+		Console:        consoleConfig,
+		Operator:       operatorConfig,
+		Infrastructure: infrastructureConfig,
+		Proxy:          proxyConfig,
+		OAuth:          oauthConfig,
+	}, nil
+}
@@ -0,0 +1,211 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	consolev1 "github.com/openshift/api/console/v1"
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	consoleclientv1 "github.com/openshift/client-go/console/clientset/versioned/typed/console/v1"
+	consolepluginsinformersv1 "github.com/openshift/client-go/console/informers/externalversions/console/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	"github.com/openshift/console-operator/pkg/api"
+)
+
+const pluginsConditionsPrefix = "Plugins"
+const pluginsFieldManager = "console-plugins-controller"
+
+// ConsolePluginsController reconciles the set of ConsolePlugin CRs named in
+// the Console operator config's Spec.Plugins. For each it validates the
+// plugin's backing Service exists, aggregates the CA bundles the console
+// deployment needs to trust those Services into a single ConfigMap in
+// openshift-console, and records which plugins actually resolved so
+// ConsoleConfigMapController can rewrite the plugins section of
+// console-config.yaml from what was observed rather than from spec alone -
+// a plugin can be listed in spec without having a working backend.
+type ConsolePluginsController struct {
+	operatorClient  v1helpers.OperatorClient
+	configSetGetter *configSetGetter
+	pluginsClient   consoleclientv1.ConsolePluginsGetter
+	serviceClient   coreclientv1.ServicesGetter
+	configMapClient coreclientv1.ConfigMapsGetter
+	observedPlugins *pluginObservationCache
+	deleteState     deleteState
+}
+
+func NewConsolePluginsController(
+	operatorClient v1helpers.OperatorClient,
+	configSetGetter *configSetGetter,
+	pluginsClient consoleclientv1.ConsolePluginsGetter,
+	consolePluginInformer consolepluginsinformersv1.ConsolePluginInformer,
+	serviceClient coreclientv1.ServicesGetter,
+	services corev1informers.ServiceInformer,
+	configMapClient coreclientv1.ConfigMapsGetter,
+	configMaps corev1informers.ConfigMapInformer,
+	observedPlugins *pluginObservationCache,
+	recorder events.Recorder,
+) (factory.Controller, removableResource) {
+	c := &ConsolePluginsController{
+		operatorClient:  operatorClient,
+		configSetGetter: configSetGetter,
+		pluginsClient:   pluginsClient,
+		serviceClient:   serviceClient,
+		configMapClient: configMapClient,
+		observedPlugins: observedPlugins,
+	}
+
+	ctrl := factory.New().
+		WithInformers(consolePluginInformer.Informer(), services.Informer(), configMaps.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsolePluginsController", recorder.WithComponentSuffix("console-plugins-controller"))
+	return ctrl, c
+}
+
+func (c *ConsolePluginsController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	configs, err := c.configSetGetter.ConfigSet(ctx)
+	if isConfigMissing(err) {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, nil, "ConfigMissing", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var observedNames []string
+	bundles := map[string]string{}
+	pluginConditions := make([]*applyoperatorv1.OperatorConditionApplyConfiguration, 0, len(configs.Operator.Spec.Plugins)*2)
+
+	for _, name := range configs.Operator.Spec.Plugins {
+		caBundle, err := c.resolvePlugin(ctx, name)
+		if err != nil {
+			pluginConditions = append(pluginConditions,
+				applyoperatorv1.OperatorCondition().
+					WithType(fmt.Sprintf("Plugin%sAvailable", name)).
+					WithStatus(operatorsv1.ConditionFalse).
+					WithReason("PluginBackendUnavailable").
+					WithMessage(err.Error()),
+				applyoperatorv1.OperatorCondition().
+					WithType(fmt.Sprintf("Plugin%sDegraded", name)).
+					WithStatus(operatorsv1.ConditionTrue).
+					WithReason("PluginBackendUnavailable").
+					WithMessage(err.Error()),
+			)
+			continue
+		}
+
+		observedNames = append(observedNames, name)
+		bundles[name] = caBundle
+		pluginConditions = append(pluginConditions,
+			applyoperatorv1.OperatorCondition().
+				WithType(fmt.Sprintf("Plugin%sAvailable", name)).
+				WithStatus(operatorsv1.ConditionTrue).
+				WithReason("AsExpected"),
+			applyoperatorv1.OperatorCondition().
+				WithType(fmt.Sprintf("Plugin%sDegraded", name)).
+				WithStatus(operatorsv1.ConditionFalse).
+				WithReason("AsExpected"),
+		)
+	}
+
+	required, err := aggregatedPluginsCABundle(bundles)
+	if err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, pluginConditions, "PluginsCABundleBuildFailed", err)
+	}
+	if _, _, err := resourceapply.ApplyConfigMap(ctx, c.configMapClient, controllerContext.Recorder(), required); err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, pluginConditions, "PluginsCABundleApplyFailed", err)
+	}
+
+	c.observedPlugins.set(observedNames)
+
+	conditions := append(pluginConditions,
+		applyoperatorv1.OperatorCondition().
+			WithType(pluginsConditionsPrefix+"Available").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason("AsExpected"),
+		applyoperatorv1.OperatorCondition().
+			WithType(pluginsConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+	return applyStatus(ctx, c.operatorClient, pluginsFieldManager, operatorStatus.ObservedGeneration, conditions...)
+}
+
+// resolvePlugin looks up the named ConsolePlugin and its backing Service and
+// returns the CA bundle the console deployment needs to trust it.
+func (c *ConsolePluginsController) resolvePlugin(ctx context.Context, name string) (string, error) {
+	plugin, err := c.pluginsClient.ConsolePlugins().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting ConsolePlugin %q: %w", name, err)
+	}
+
+	if plugin.Spec.Backend.Type != consolev1.Service || plugin.Spec.Backend.Service == nil {
+		return "", fmt.Errorf("plugin %q has no Service backend", name)
+	}
+
+	service, err := c.serviceClient.Services(plugin.Spec.Backend.Service.Namespace).Get(ctx, plugin.Spec.Backend.Service.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return "", fmt.Errorf("backing service %s/%s not found", plugin.Spec.Backend.Service.Namespace, plugin.Spec.Backend.Service.Name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("getting backing service %s/%s: %w", plugin.Spec.Backend.Service.Namespace, plugin.Spec.Backend.Service.Name, err)
+	}
+
+	if err := validateBackendService(plugin, service); err != nil {
+		return "", err
+	}
+
+	caBundle, err := resolveCABundle(ctx, c.configMapClient, service)
+	if err != nil {
+		return "", fmt.Errorf("resolving CA bundle for plugin %q: %w", name, err)
+	}
+	return caBundle, nil
+}
+
+func (c *ConsolePluginsController) degraded(ctx context.Context, observedGeneration int64, pluginConditions []*applyoperatorv1.OperatorConditionApplyConfiguration, reason string, err error) error {
+	conditions := append(pluginConditions,
+		applyoperatorv1.OperatorCondition().
+			WithType(pluginsConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason(reason).
+			WithMessage(err.Error()),
+	)
+	_ = applyStatus(ctx, c.operatorClient, pluginsFieldManager, observedGeneration, conditions...)
+	return err
+}
+
+// tombstone deletes the aggregated plugins CA bundle ConfigMap as part of
+// Removed state teardown, the same annotation-driven protocol every other
+// ConfigMap controller's tombstone uses.
+func (c *ConsolePluginsController) tombstone(ctx context.Context) (tombstoneResult, error) {
+	name := pluginsCABundleStub().Name
+	outcome, err := requestDelete(ctx, funcObjectDeleter{
+		getFn: func(ctx context.Context) (metav1.Object, error) {
+			return c.configMapClient.ConfigMaps(api.TargetNamespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		annotate_: func(ctx context.Context, key, value string) error {
+			return annotateObject[*corev1.ConfigMap](ctx, c.configMapClient.ConfigMaps(api.TargetNamespace), name, key, value)
+		},
+		deleteFn: func(ctx context.Context) error {
+			return c.configMapClient.ConfigMaps(api.TargetNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+	}, &c.deleteState)
+	return tombstoneResult{Done: outcome == deleteComplete, Reappeared: outcome == deleteReappeared}, err
+}
@@ -0,0 +1,197 @@
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	appsv1informers "k8s.io/client-go/informers/apps/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	appsv1client "k8s.io/client-go/kubernetes/typed/apps/v1"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	consolepluginsinformersv1 "github.com/openshift/client-go/console/informers/externalversions/console/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/resource/resourcemerge"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	"github.com/openshift/console-operator/pkg/api"
+	"github.com/openshift/console-operator/pkg/console/subresource/deployment"
+)
+
+// deploymentConditionsPrefix is prepended to every condition type this
+// controller owns, e.g. "DeploymentAvailable", "DeploymentDegraded".
+const deploymentConditionsPrefix = "Deployment"
+
+// deploymentFieldManager scopes this controller's status writes so it never
+// stomps on conditions owned by another controller.
+const deploymentFieldManager = "console-deployment-controller"
+
+// ConsoleDeploymentController keeps the console Deployment in
+// openshift-console in sync with the operator config. It owns the
+// Deployment* family of status conditions and no longer shares a single
+// Sync with every other console resource.
+type ConsoleDeploymentController struct {
+	operatorClient   v1helpers.OperatorClient
+	configSetGetter  *configSetGetter
+	deploymentClient appsv1client.DeploymentsGetter
+	observedPlugins  *pluginObservationCache
+	deleteState      deleteState
+}
+
+// deploymentStatusExtension carries status detail that doesn't fit the
+// generic OperatorStatus.Conditions shape (a replica count, not a
+// true/false/unknown), via the status Extension escape hatch rather than a
+// human-readable condition Message.
+type deploymentStatusExtension struct {
+	ReadyReplicas int32 `json:"readyReplicas"`
+}
+
+func NewConsoleDeploymentController(
+	operatorClient v1helpers.OperatorClient,
+	configSetGetter *configSetGetter,
+	deploymentClient appsv1client.DeploymentsGetter,
+	deployments appsv1informers.DeploymentInformer,
+	observedPlugins *pluginObservationCache,
+	consolePlugins consolepluginsinformersv1.ConsolePluginInformer,
+	services corev1informers.ServiceInformer,
+	recorder events.Recorder,
+) (factory.Controller, removableResource) {
+	c := &ConsoleDeploymentController{
+		operatorClient:   operatorClient,
+		configSetGetter:  configSetGetter,
+		deploymentClient: deploymentClient,
+		observedPlugins:  observedPlugins,
+	}
+
+	ctrl := factory.New().
+		WithFilteredEventsInformers(namesFilter(api.OpenShiftConsoleName), deployments.Informer()).
+		// Plugin and backing-Service changes don't touch the Deployment
+		// directly, but they can change what observedPlugins.get() returns -
+		// without watching these too, a plugin resolving (or breaking)
+		// wouldn't trigger a resync until something else touched the
+		// Deployment.
+		WithInformers(consolePlugins.Informer(), services.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsoleDeploymentController", recorder.WithComponentSuffix("console-deployment-controller"))
+	return ctrl, c
+}
+
+func (c *ConsoleDeploymentController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	configs, err := c.configSetGetter.ConfigSet(ctx)
+	if isConfigMissing(err) {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "ConfigMissing", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	// The plugins CA bundle ConfigMap is keyed per observed plugin, so only
+	// mount trust for plugins that actually resolved a working backend - a
+	// plugin can be listed in spec without one, the same reason
+	// ConsoleConfigMapController writes console-config.yaml's plugins
+	// section from observed rather than spec.
+	operatorConfig := configs.Operator.DeepCopy()
+	operatorConfig.Spec.Plugins = c.observedPlugins.get()
+
+	requiredDeployment := deployment.DefaultDeployment(operatorConfig, configs.Console, configs.Infrastructure, configs.Proxy)
+	requiredDeployment = withPluginsCABundleMount(requiredDeployment, len(operatorConfig.Spec.Plugins) > 0)
+	actualDeployment, _, err := resourceapply.ApplyDeployment(
+		ctx,
+		c.deploymentClient,
+		controllerContext.Recorder(),
+		requiredDeployment,
+		resourcemerge.ExpectedDeploymentGeneration(requiredDeployment, operatorStatus.ObservedGeneration),
+	)
+	if err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "DeploymentApplyFailed", err)
+	}
+
+	extension, err := json.Marshal(deploymentStatusExtension{ReadyReplicas: actualDeployment.Status.ReadyReplicas})
+	if err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "DeploymentStatusMarshalFailed", err)
+	}
+
+	available := actualDeployment.Status.AvailableReplicas > 0
+	status := applyoperatorv1.OperatorStatus().
+		WithObservedGeneration(operatorStatus.ObservedGeneration).
+		WithExtension(runtime.RawExtension{Raw: extension}).
+		WithConditions(
+			applyoperatorv1.OperatorCondition().
+				WithType(deploymentConditionsPrefix+"Available").
+				WithStatus(toConditionStatus(available)).
+				WithReason("AsExpected").
+				WithMessage(fmt.Sprintf("%d/%d replicas ready", actualDeployment.Status.ReadyReplicas, *requiredDeployment.Spec.Replicas)),
+			applyoperatorv1.OperatorCondition().
+				WithType(deploymentConditionsPrefix+"Degraded").
+				WithStatus(operatorsv1.ConditionFalse).
+				WithReason("AsExpected"),
+		)
+	return c.operatorClient.ApplyOperatorStatus(ctx, deploymentFieldManager, status)
+}
+
+// withPluginsCABundleMount mounts the aggregated console-plugins-trusted-ca-bundle
+// ConfigMap ConsolePluginsController builds into every container of d, so a
+// plugin's CA override (pluginCABundleConfigMapAnnotation) actually reaches
+// the running console process instead of sitting unread in a ConfigMap.
+// Nothing is mounted when no plugin resolved a working backend, since the
+// aggregated ConfigMap is empty in that case.
+func withPluginsCABundleMount(d *appsv1.Deployment, hasPlugins bool) *appsv1.Deployment {
+	if !hasPlugins {
+		return d
+	}
+	d = d.DeepCopy()
+	podSpec := &d.Spec.Template.Spec
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: pluginsCABundleVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: pluginsCABundleConfigMapName},
+			},
+		},
+	})
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].VolumeMounts = append(podSpec.Containers[i].VolumeMounts, corev1.VolumeMount{
+			Name:      pluginsCABundleVolumeName,
+			MountPath: pluginsCABundleMountPath,
+			ReadOnly:  true,
+		})
+	}
+	return d
+}
+
+func (c *ConsoleDeploymentController) degraded(ctx context.Context, observedGeneration int64, reason string, err error) error {
+	return degradedStatus(ctx, c.operatorClient, deploymentFieldManager, deploymentConditionsPrefix, observedGeneration, reason, err)
+}
+
+// tombstone deletes the console Deployment as part of Removed state teardown.
+func (c *ConsoleDeploymentController) tombstone(ctx context.Context) (tombstoneResult, error) {
+	name := deployment.Stub().Name
+	outcome, err := requestDelete(ctx, funcObjectDeleter{
+		getFn: func(ctx context.Context) (metav1.Object, error) {
+			return c.deploymentClient.Deployments(api.TargetNamespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		annotate_: func(ctx context.Context, key, value string) error {
+			return annotateObject[*appsv1.Deployment](ctx, c.deploymentClient.Deployments(api.TargetNamespace), name, key, value)
+		},
+		deleteFn: func(ctx context.Context) error {
+			return c.deploymentClient.Deployments(api.TargetNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+	}, &c.deleteState)
+	return tombstoneResult{Done: outcome == deleteComplete, Reappeared: outcome == deleteReappeared}, err
+}
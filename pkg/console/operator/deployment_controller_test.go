@@ -0,0 +1,53 @@
+package operator
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func fakeDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "console"}},
+				},
+			},
+		},
+	}
+}
+
+func TestWithPluginsCABundleMountNoPlugins(t *testing.T) {
+	d := fakeDeployment()
+	got := withPluginsCABundleMount(d, false)
+	if len(got.Spec.Template.Spec.Volumes) != 0 {
+		t.Fatalf("expected no volumes added, got %v", got.Spec.Template.Spec.Volumes)
+	}
+	if len(got.Spec.Template.Spec.Containers[0].VolumeMounts) != 0 {
+		t.Fatalf("expected no volume mounts added, got %v", got.Spec.Template.Spec.Containers[0].VolumeMounts)
+	}
+}
+
+func TestWithPluginsCABundleMountWithPlugins(t *testing.T) {
+	d := fakeDeployment()
+	got := withPluginsCABundleMount(d, true)
+
+	if len(got.Spec.Template.Spec.Volumes) != 1 {
+		t.Fatalf("expected one volume added, got %v", got.Spec.Template.Spec.Volumes)
+	}
+	volume := got.Spec.Template.Spec.Volumes[0]
+	if volume.Name != pluginsCABundleVolumeName || volume.ConfigMap == nil || volume.ConfigMap.Name != pluginsCABundleConfigMapName {
+		t.Fatalf("unexpected volume: %+v", volume)
+	}
+
+	mounts := got.Spec.Template.Spec.Containers[0].VolumeMounts
+	if len(mounts) != 1 || mounts[0].Name != pluginsCABundleVolumeName || mounts[0].MountPath != pluginsCABundleMountPath {
+		t.Fatalf("unexpected volume mounts: %v", mounts)
+	}
+
+	if len(d.Spec.Template.Spec.Volumes) != 0 {
+		t.Fatalf("expected original deployment left untouched, got %v", d.Spec.Template.Spec.Volumes)
+	}
+}
@@ -0,0 +1,165 @@
+package operator
+
+import (
+	"context"
+	"time"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	operatorinformerv1 "github.com/openshift/client-go/operator/informers/externalversions/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+)
+
+const managementStateFieldManager = "console-managementstate-controller"
+
+// removalResyncInterval drives Sync while ManagementState is Removed, since
+// this controller only watches the operator config informer: the
+// per-resource controllers it asks to tear down via removableResource don't
+// notify it back when a resource finishes terminating or reappears, so
+// without a resync ConsoleRemovalProgressing could stick at True until an
+// unrelated operator-config event happened to retrigger Sync.
+const removalResyncInterval = 10 * time.Second
+
+// tombstoneResult reports how far a removableResource has gotten through its
+// own teardown on this Sync, so removeConsole can fold every resource's
+// progress into a single Removed-state status condition instead of the
+// operator having no feedback beyond "it didn't error".
+type tombstoneResult struct {
+	// Done is true once the resource is confirmed torn down.
+	Done bool
+	// Reappeared is true if the resource was previously torn down (or asked
+	// to be) and has since come back, e.g. recreated by something else.
+	Reappeared bool
+}
+
+// removableResource is implemented by the per-resource controllers that own
+// a console-managed object which must be torn down when the operator's
+// ManagementState moves to Removed. tombstone is idempotent: it is called
+// on every Sync while Removed, and reports progress rather than just error.
+type removableResource interface {
+	tombstone(ctx context.Context) (tombstoneResult, error)
+}
+
+// consoleManagementStateController is the thin top-level controller that
+// used to be consoleOperator.handleSync. It no longer owns any resource
+// directly; it only watches Spec.ManagementState and, on Removed, asks each
+// per-resource controller to tear down its own piece and reports aggregate
+// progress via ConsoleRemovalProgressing/ConsoleRemovalDegraded.
+type consoleManagementStateController struct {
+	operatorClient     v1helpers.OperatorClient
+	removableResources []removableResource
+}
+
+func newConsoleManagementStateController(
+	operatorClient v1helpers.OperatorClient,
+	operatorConfigInformer operatorinformerv1.ConsoleInformer,
+	removableResources []removableResource,
+	recorder events.Recorder,
+) factory.Controller {
+	c := &consoleManagementStateController{
+		operatorClient:     operatorClient,
+		removableResources: removableResources,
+	}
+
+	return factory.New().
+		WithInformers(operatorConfigInformer.Informer()).
+		ResyncEvery(removalResyncInterval).
+		WithSync(c.Sync).
+		ToController("ConsoleManagementStateController", recorder.WithComponentSuffix("console-managementstate-controller"))
+}
+
+func (c *consoleManagementStateController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+
+	switch operatorSpec.ManagementState {
+	case operatorsv1.Managed, operatorsv1.Unmanaged:
+		// the per-resource controllers decide for themselves whether to act;
+		// nothing to do here.
+		return nil
+	case operatorsv1.Removed:
+		return c.removeConsole(ctx, operatorStatus.ObservedGeneration)
+	default:
+		return applyStatus(ctx, c.operatorClient, managementStateFieldManager, operatorStatus.ObservedGeneration,
+			applyoperatorv1.OperatorCondition().
+				WithType("ManagementStateDegraded").
+				WithStatus(operatorsv1.ConditionTrue).
+				WithReason("UnknownManagementState").
+				WithMessage(string(operatorSpec.ManagementState)),
+		)
+	}
+}
+
+// removeConsole asks every per-resource controller to advance its own
+// teardown and folds the results into ConsoleRemovalProgressing /
+// ConsoleRemovalDegraded so users can see whether removal is still in
+// flight, stuck on a reappearing resource, or actually failing - instead of
+// the old behavior of silently swallowing every IsNotFound and reporting
+// nothing at all.
+func (c *consoleManagementStateController) removeConsole(ctx context.Context, observedGeneration int64) error {
+	var errs []error
+	allDone := true
+	anyReappeared := false
+	for _, resource := range c.removableResources {
+		result, err := resource.tombstone(ctx)
+		if err != nil {
+			errs = append(errs, err)
+			allDone = false
+			continue
+		}
+		if !result.Done {
+			allDone = false
+		}
+		if result.Reappeared {
+			anyReappeared = true
+		}
+	}
+
+	if aggregate := utilerrors.NewAggregate(errs); aggregate != nil {
+		_ = applyStatus(ctx, c.operatorClient, managementStateFieldManager, observedGeneration,
+			applyoperatorv1.OperatorCondition().
+				WithType("ConsoleRemovalProgressing").
+				WithStatus(operatorsv1.ConditionTrue).
+				WithReason("RemovalInProgress").
+				WithMessage("waiting for console-managed resources to finish terminating"),
+			applyoperatorv1.OperatorCondition().
+				WithType("ConsoleRemovalDegraded").
+				WithStatus(operatorsv1.ConditionTrue).
+				WithReason("RemovalFailed").
+				WithMessage(aggregate.Error()),
+		)
+		return aggregate
+	}
+
+	progressing := operatorsv1.ConditionFalse
+	reason := "AsExpected"
+	message := "console removal complete"
+	if !allDone {
+		progressing = operatorsv1.ConditionTrue
+		reason = "RemovalInProgress"
+		message = "waiting for console-managed resources to finish terminating"
+	}
+	if anyReappeared {
+		progressing = operatorsv1.ConditionTrue
+		reason = "ResourceReappeared"
+		message = "a console-managed resource reappeared after deletion was requested; re-requesting deletion"
+	}
+
+	return applyStatus(ctx, c.operatorClient, managementStateFieldManager, observedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType("ConsoleRemovalProgressing").
+			WithStatus(progressing).
+			WithReason(reason).
+			WithMessage(message),
+		applyoperatorv1.OperatorCondition().
+			WithType("ConsoleRemovalDegraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+}
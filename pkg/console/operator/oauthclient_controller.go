@@ -0,0 +1,128 @@
+package operator
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	oauthclientv1 "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
+	oauthinformersv1 "github.com/openshift/client-go/oauth/informers/externalversions/oauth/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	"github.com/openshift/console-operator/pkg/api"
+	"github.com/openshift/console-operator/pkg/console/subresource/oauthclient"
+)
+
+const oauthClientConditionsPrefix = "OAuthClient"
+const oauthClientFieldManager = "console-oauthclient-controller"
+
+// ConsoleOAuthClientController keeps the console OAuthClient registered and
+// pointed at the current route host. It owns the OAuthClient* conditions.
+type ConsoleOAuthClientController struct {
+	operatorClient  v1helpers.OperatorClient
+	configSetGetter *configSetGetter
+	oauthClient     oauthclientv1.OAuthClientsGetter
+}
+
+func NewConsoleOAuthClientController(
+	operatorClient v1helpers.OperatorClient,
+	configSetGetter *configSetGetter,
+	oauthClient oauthclientv1.OAuthClientsGetter,
+	oauthClients oauthinformersv1.OAuthClientInformer,
+	recorder events.Recorder,
+) (factory.Controller, removableResource) {
+	c := &ConsoleOAuthClientController{
+		operatorClient:  operatorClient,
+		configSetGetter: configSetGetter,
+		oauthClient:     oauthClient,
+	}
+
+	ctrl := factory.New().
+		WithFilteredEventsInformers(namesFilter(api.OAuthClientName), oauthClients.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsoleOAuthClientController", recorder.WithComponentSuffix("console-oauthclient-controller"))
+	return ctrl, c
+}
+
+func (c *ConsoleOAuthClientController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	configs, err := c.configSetGetter.ConfigSet(ctx)
+	if isConfigMissing(err) {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "ConfigMissing", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.oauthClient.OAuthClients().Get(ctx, oauthclient.Stub().Name, metav1.GetOptions{})
+	if err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "OAuthClientGetFailed", err)
+	}
+
+	required := oauthclient.RegisterConsoleToOAuthClient(existing, configs.Infrastructure, configs.Console)
+	if _, err := c.oauthClient.OAuthClients().Update(ctx, required, metav1.UpdateOptions{}); err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "OAuthClientUpdateFailed", err)
+	}
+
+	return applyStatus(ctx, c.operatorClient, oauthClientFieldManager, operatorStatus.ObservedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType(oauthClientConditionsPrefix+"Synced").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason("AsExpected"),
+		applyoperatorv1.OperatorCondition().
+			WithType(oauthClientConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+}
+
+func (c *ConsoleOAuthClientController) degraded(ctx context.Context, observedGeneration int64, reason string, err error) error {
+	return degradedStatus(ctx, c.operatorClient, oauthClientFieldManager, oauthClientConditionsPrefix, observedGeneration, reason, err)
+}
+
+// oauthClientDeregisteredAnnotation marks the cluster OAuthClient as already
+// deregistered so repeated Syncs while Removed don't keep issuing the same
+// Update against a shared, pre-existing resource.
+const oauthClientDeregisteredAnnotation = "console.operator.openshift.io/oauthclient-deregistered-at"
+
+// tombstone deregisters the console from the cluster OAuthClient rather than
+// deleting it, since the OAuthClient is a shared, pre-existing resource. It
+// only clears RedirectURIs once, recording oauthClientDeregisteredAnnotation
+// so a later Sync that finds RedirectURIs re-populated (e.g. by an admin)
+// doesn't mistake that for "deregistration never happened".
+func (c *ConsoleOAuthClientController) tombstone(ctx context.Context) (tombstoneResult, error) {
+	existing, err := c.oauthClient.OAuthClients().Get(ctx, oauthclient.Stub().Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return tombstoneResult{Done: true}, nil
+	}
+	if err != nil {
+		return tombstoneResult{}, err
+	}
+
+	if _, deregistered := existing.Annotations[oauthClientDeregisteredAnnotation]; deregistered {
+		return tombstoneResult{Done: true}, nil
+	}
+
+	updated := oauthclient.DeRegisterConsoleFromOAuthClient(existing.DeepCopy())
+	if updated.Annotations == nil {
+		updated.Annotations = map[string]string{}
+	}
+	updated.Annotations[oauthClientDeregisteredAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if _, err := c.oauthClient.OAuthClients().Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return tombstoneResult{}, err
+	}
+	return tombstoneResult{Done: true}, nil
+}
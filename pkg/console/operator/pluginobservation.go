@@ -0,0 +1,33 @@
+package operator
+
+import "sync"
+
+// pluginObservationCache holds the most recently observed set of plugin
+// names ConsolePluginsController resolved a healthy backing Service for.
+// ConsoleConfigMapController reads from it when rendering the plugins
+// section of console-config.yaml, and ConsoleDeploymentController when
+// deciding whether to mount the plugins CA bundle, instead of trusting
+// operator.Spec.Plugins verbatim, since a plugin can be listed in spec
+// without actually having a resolvable Service. A small shared cache is
+// simpler here than plumbing a lister through these controllers for a value
+// that one computes and the others only read.
+type pluginObservationCache struct {
+	mu      sync.RWMutex
+	plugins []string
+}
+
+func newPluginObservationCache() *pluginObservationCache {
+	return &pluginObservationCache{}
+}
+
+func (c *pluginObservationCache) set(plugins []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.plugins = append([]string(nil), plugins...)
+}
+
+func (c *pluginObservationCache) get() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]string(nil), c.plugins...)
+}
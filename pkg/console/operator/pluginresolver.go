@@ -0,0 +1,104 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	consolev1 "github.com/openshift/api/console/v1"
+
+	"github.com/openshift/console-operator/pkg/api"
+)
+
+// pluginCABundleConfigMapAnnotation lets a plugin's backend Service name a
+// ConfigMap holding the CA that signed its serving certificate, for plugins
+// fronted by something other than the cluster's service-ca controller. Most
+// plugins don't need it: their Service gets a service-ca.io serving cert and
+// the cluster-wide service-ca ConfigMap already in openshift-console covers
+// trust for all of them.
+const pluginCABundleConfigMapAnnotation = "console.openshift.io/trusted-ca-bundle-configmap"
+
+// pluginCABundleConfigMapKey is the data key holding the PEM bundle in both
+// the cluster service-ca ConfigMap and any user-supplied override named via
+// pluginCABundleConfigMapAnnotation.
+const pluginCABundleConfigMapKey = "service-ca.crt"
+
+// pluginsCABundleConfigMapName is the aggregated ConfigMap this controller
+// applies, keyed per plugin so the console deployment can mount one CA
+// bundle per plugin's backend rather than a single cluster-wide bundle that
+// couldn't express a plugin-specific override.
+const pluginsCABundleConfigMapName = "console-plugins-trusted-ca-bundle"
+
+// pluginsCABundleVolumeName and pluginsCABundleMountPath are where
+// ConsoleDeploymentController mounts pluginsCABundleConfigMapName into the
+// console container, so a plugin's CA override actually reaches the console
+// process instead of sitting unread in a ConfigMap.
+const pluginsCABundleVolumeName = "plugins-trusted-ca-bundle"
+const pluginsCABundleMountPath = "/var/run/plugins-trusted-ca-bundle"
+
+// validateBackendService checks that the plugin's declared backend port
+// actually exists on the Service ConsolePluginsController resolved it
+// against, so a stale or typo'd port fails fast with a clear reason instead
+// of the console deployment silently getting a dead proxy target.
+func validateBackendService(plugin *consolev1.ConsolePlugin, service *corev1.Service) error {
+	port := plugin.Spec.Backend.Service.Port
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Port == port {
+			return nil
+		}
+	}
+	return fmt.Errorf("backing service %s/%s has no port %d", service.Namespace, service.Name, port)
+}
+
+// resolveCABundle returns the PEM CA bundle the console deployment needs to
+// trust the plugin's backend Service: the ConfigMap named by the Service's
+// pluginCABundleConfigMapAnnotation if it has one, otherwise the cluster
+// service-ca bundle every other console-managed Service already trusts off.
+func resolveCABundle(ctx context.Context, configMapClient coreclientv1.ConfigMapsGetter, service *corev1.Service) (string, error) {
+	namespace, name := api.TargetNamespace, api.ServiceCAConfigMapName
+	if override, ok := service.Annotations[pluginCABundleConfigMapAnnotation]; ok && override != "" {
+		namespace, name = service.Namespace, override
+	}
+
+	bundleConfigMap, err := configMapClient.ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting CA bundle ConfigMap %s/%s: %w", namespace, name, err)
+	}
+	bundle, ok := bundleConfigMap.Data[pluginCABundleConfigMapKey]
+	if !ok {
+		return "", fmt.Errorf("CA bundle ConfigMap %s/%s has no %q key", namespace, name, pluginCABundleConfigMapKey)
+	}
+	return bundle, nil
+}
+
+// pluginsCABundleStub returns the empty aggregated ConfigMap, used both as
+// the base for aggregatedPluginsCABundle and to know what to delete on
+// teardown.
+func pluginsCABundleStub() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pluginsCABundleConfigMapName,
+			Namespace: api.TargetNamespace,
+		},
+	}
+}
+
+// aggregatedPluginsCABundle builds the ConfigMap that carries one CA bundle
+// per successfully resolved plugin, keyed by plugin name so the console
+// deployment can tell them apart - two plugins can legitimately have
+// different CAs via pluginCABundleConfigMapAnnotation.
+func aggregatedPluginsCABundle(bundles map[string]string) (*corev1.ConfigMap, error) {
+	required := pluginsCABundleStub()
+	required.Data = make(map[string]string, len(bundles))
+	for name, bundle := range bundles {
+		if strings.TrimSpace(bundle) == "" {
+			return nil, fmt.Errorf("plugin %q resolved an empty CA bundle", name)
+		}
+		required.Data[name] = bundle
+	}
+	return required, nil
+}
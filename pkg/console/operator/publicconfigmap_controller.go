@@ -0,0 +1,137 @@
+package operator
+
+import (
+	"context"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	"github.com/openshift/console-operator/pkg/api"
+	"github.com/openshift/console-operator/pkg/console/subresource/configmap"
+)
+
+const publicConfigMapConditionsPrefix = "PublicConfigMap"
+const publicConfigMapFieldManager = "console-public-configmap-controller"
+
+// ConsolePublicConfigMapController publishes the console's URL into the
+// well-known public ConfigMap in openshift-config-managed that other
+// operators and in-cluster consumers read. It lives in its own controller
+// because it reacts to the managed-namespace informer rather than the
+// target-namespace one every other ConfigMap controller uses.
+type ConsolePublicConfigMapController struct {
+	operatorClient  v1helpers.OperatorClient
+	configSetGetter *configSetGetter
+	configMapClient coreclientv1.ConfigMapsGetter
+	recorder        events.Recorder
+}
+
+func NewConsolePublicConfigMapController(
+	operatorClient v1helpers.OperatorClient,
+	configSetGetter *configSetGetter,
+	configMapClient coreclientv1.ConfigMapsGetter,
+	managedConfigMaps corev1informers.ConfigMapInformer,
+	recorder events.Recorder,
+) (factory.Controller, removableResource) {
+	c := &ConsolePublicConfigMapController{
+		operatorClient:  operatorClient,
+		configSetGetter: configSetGetter,
+		configMapClient: configMapClient,
+		recorder:        recorder,
+	}
+
+	ctrl := factory.New().
+		WithFilteredEventsInformers(namesFilter(api.OpenShiftConsolePublicConfigMapName), managedConfigMaps.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsolePublicConfigMapController", recorder.WithComponentSuffix("console-public-configmap-controller"))
+	return ctrl, c
+}
+
+func (c *ConsolePublicConfigMapController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	configs, err := c.configSetGetter.ConfigSet(ctx)
+	if isConfigMissing(err) {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "ConfigMissing", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	required := configmap.DefaultPublicConfig(configs.Console, configs.Infrastructure)
+	if _, _, err := resourceapply.ApplyConfigMap(ctx, c.configMapClient, controllerContext.Recorder(), required); err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "PublicConfigMapApplyFailed", err)
+	}
+
+	return applyStatus(ctx, c.operatorClient, publicConfigMapFieldManager, operatorStatus.ObservedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType(publicConfigMapConditionsPrefix+"Available").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason("AsExpected"),
+		applyoperatorv1.OperatorCondition().
+			WithType(publicConfigMapConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+}
+
+func (c *ConsolePublicConfigMapController) degraded(ctx context.Context, observedGeneration int64, reason string, err error) error {
+	return degradedStatus(ctx, c.operatorClient, publicConfigMapFieldManager, publicConfigMapConditionsPrefix, observedGeneration, reason, err)
+}
+
+// publicConfigClearedAnnotation marks the public ConfigMap as already
+// cleared so repeated Syncs while Removed don't keep re-applying the empty
+// body against a ConfigMap other operators also read and may react to.
+const publicConfigClearedAnnotation = "console.operator.openshift.io/public-config-cleared-at"
+
+// tombstone clears the console URL from the public config map rather than
+// deleting it outright, since other operators also read this ConfigMap. It
+// only applies the empty body once per clear request, using the same
+// annotation protocol as requestDelete so a later Sync that finds the
+// content diverged from the cleared body (e.g. repopulated by a stale
+// controller) is reported as the resource having reappeared rather than
+// deregistration never having happened. EmptyPublicConfig's Data is
+// empty-but-non-nil, so reappearance must be detected by comparing against
+// that expected cleared content rather than by a nil check.
+func (c *ConsolePublicConfigMapController) tombstone(ctx context.Context) (tombstoneResult, error) {
+	required := configmap.EmptyPublicConfig()
+	existing, err := c.configMapClient.ConfigMaps(required.Namespace).Get(ctx, required.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return tombstoneResult{Done: true}, nil
+	}
+	if err != nil {
+		return tombstoneResult{}, err
+	}
+
+	_, alreadyCleared := existing.Annotations[publicConfigClearedAnnotation]
+	reappeared := alreadyCleared && !apiequality.Semantic.DeepEqual(existing.Data, required.Data)
+	if alreadyCleared && !reappeared {
+		return tombstoneResult{Done: true}, nil
+	}
+
+	if required.Annotations == nil {
+		required.Annotations = map[string]string{}
+	}
+	required.Annotations[publicConfigClearedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if _, _, err := resourceapply.ApplyConfigMap(ctx, c.configMapClient, c.recorder, required); err != nil {
+		return tombstoneResult{}, err
+	}
+
+	return tombstoneResult{Done: true, Reappeared: reappeared}, nil
+}
@@ -0,0 +1,164 @@
+package operator
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// deleteRequestedAtAnnotation is set on a console-managed object the moment
+// the operator asks the apiserver to delete it, mirroring the CVO's
+// release.openshift.io/delete manifest annotation. Without it, removeConsole
+// had no way to distinguish "never existed", "we deleted it and it's still
+// terminating", and "we deleted it and something else recreated it" -
+// FilterOut(..., errors.IsNotFound) collapsed all three into "fine, move on".
+const deleteRequestedAtAnnotation = "console.operator.openshift.io/delete-requested-at"
+
+// deleteOutcome is what requestDelete observed about a resource's teardown
+// on this Sync.
+type deleteOutcome int
+
+const (
+	// deleteComplete means the object is confirmed gone.
+	deleteComplete deleteOutcome = iota
+	// deletePending means deletion has been requested (or re-requested) and
+	// the object still exists, terminating or otherwise.
+	deletePending
+	// deleteReappeared means the object was previously confirmed deleted (or
+	// was deleted and recreated with a different UID before we ever observed
+	// it gone), and now exists again - something else recreated it.
+	deleteReappeared
+)
+
+// deleteState is the per-resource memory requestDelete needs to tell a
+// genuine reappearance apart from the normal first-request and
+// still-terminating cases, since once the object is actually gone its
+// annotation goes with it. Callers hold one of these alongside the
+// controller they drive requestDelete from and pass the same pointer in on
+// every Sync.
+type deleteState struct {
+	// requestedUID is the UID of the object we last issued a delete against.
+	requestedUID types.UID
+	// completed is true once a Sync has observed the object gone.
+	completed bool
+}
+
+// objectDeleter is implemented by a small per-resource adapter so
+// requestDelete can drive the annotate-then-delete protocol for any
+// console-managed object without every tombstone method reimplementing the
+// same get/annotate/delete bookkeeping.
+type objectDeleter interface {
+	get(ctx context.Context) (metav1.Object, error)
+	annotate(ctx context.Context, key, value string) error
+	delete(ctx context.Context) error
+}
+
+// funcObjectDeleter adapts three closures to the objectDeleter interface so
+// call sites can build one inline instead of declaring a named type per
+// resource.
+type funcObjectDeleter struct {
+	getFn     func(ctx context.Context) (metav1.Object, error)
+	annotate_ func(ctx context.Context, key, value string) error
+	deleteFn  func(ctx context.Context) error
+}
+
+func (f funcObjectDeleter) get(ctx context.Context) (metav1.Object, error) { return f.getFn(ctx) }
+func (f funcObjectDeleter) annotate(ctx context.Context, key, value string) error {
+	return f.annotate_(ctx, key, value)
+}
+func (f funcObjectDeleter) delete(ctx context.Context) error { return f.deleteFn(ctx) }
+
+// requestDelete drives one resource through the annotate-then-delete
+// protocol. The first Sync after ManagementState flips to Removed annotates
+// the object with deleteRequestedAtAnnotation and issues Delete; every Sync
+// after that checks whether the object is gone, still terminating, or has
+// reappeared, re-annotating and re-deleting a reappeared object and
+// re-issuing Delete for one that is neither gone nor terminating yet. state
+// must be the same pointer across every call for a
+// given resource: the object's own annotation disappears along with it once
+// it's actually deleted, so requestDelete can't tell a reappearance from a
+// first request by looking at the object alone.
+func requestDelete(ctx context.Context, d objectDeleter, state *deleteState) (deleteOutcome, error) {
+	existing, err := d.get(ctx)
+	if errors.IsNotFound(err) {
+		state.completed = true
+		state.requestedUID = ""
+		return deleteComplete, nil
+	}
+	if err != nil {
+		return deletePending, err
+	}
+
+	// Either we already saw this resource confirmed gone, or it was
+	// recreated with a different UID before a Sync ever caught it missing -
+	// in both cases this is not the object we asked to be deleted.
+	reappeared := state.completed || (state.requestedUID != "" && existing.GetUID() != state.requestedUID)
+
+	_, alreadyRequested := existing.GetAnnotations()[deleteRequestedAtAnnotation]
+	if !alreadyRequested || reappeared {
+		if err := d.annotate(ctx, deleteRequestedAtAnnotation, time.Now().UTC().Format(time.RFC3339)); err != nil {
+			return deletePending, err
+		}
+		if err := d.delete(ctx); err != nil && !errors.IsNotFound(err) {
+			return deletePending, err
+		}
+		state.completed = false
+		state.requestedUID = existing.GetUID()
+		if reappeared {
+			return deleteReappeared, nil
+		}
+		return deletePending, nil
+	}
+
+	if existing.GetDeletionTimestamp() != nil {
+		return deletePending, nil
+	}
+
+	// Already annotated, same UID we requested, not terminating: the delete
+	// call hasn't taken effect yet. Re-issue it without treating this as a
+	// reappearance.
+	if err := d.delete(ctx); err != nil && !errors.IsNotFound(err) {
+		return deletePending, err
+	}
+	return deletePending, nil
+}
+
+// annotatable is satisfied by any generated client object (ConfigMap,
+// Secret, Service, Route, Deployment, ...): metav1.Object for the
+// annotation accessors, runtime.Object so it can be deep-copied generically.
+type annotatable interface {
+	metav1.Object
+	runtime.Object
+}
+
+// annotator is the minimal get/update pair every per-resource tombstone
+// closure needs to set an annotation. Parameterizing requestDelete's
+// annotate closure over this instead of repeating the
+// get-DeepCopy-set-Update sequence in every controller keeps that sequence
+// in one place.
+type annotator[T annotatable] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	Update(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+}
+
+// annotateObject sets key=value on the named object via client, re-reading
+// it first so the update applies on top of the current resourceVersion.
+func annotateObject[T annotatable](ctx context.Context, client annotator[T], name, key, value string) error {
+	existing, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	existing = existing.DeepCopyObject().(T)
+	annotations := existing.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	existing.SetAnnotations(annotations)
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
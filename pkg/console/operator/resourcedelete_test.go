@@ -0,0 +1,164 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// fakeObject is the minimal metav1.Object fakeDeleter hands back from get.
+type fakeObject struct {
+	metav1.ObjectMeta
+}
+
+type fakeDeleter struct {
+	object      *fakeObject
+	annotateErr error
+	deleteErr   error
+
+	annotateCalls int
+	deleteCalls   int
+}
+
+func (f *fakeDeleter) get(ctx context.Context) (metav1.Object, error) {
+	if f.object == nil {
+		return nil, errors.NewNotFound(schema.GroupResource{Resource: "fakes"}, "fake")
+	}
+	return f.object, nil
+}
+
+func (f *fakeDeleter) annotate(ctx context.Context, key, value string) error {
+	f.annotateCalls++
+	if f.annotateErr != nil {
+		return f.annotateErr
+	}
+	if f.object.Annotations == nil {
+		f.object.Annotations = map[string]string{}
+	}
+	f.object.Annotations[key] = value
+	return nil
+}
+
+func (f *fakeDeleter) delete(ctx context.Context) error {
+	f.deleteCalls++
+	if f.deleteErr != nil {
+		return f.deleteErr
+	}
+	f.object = nil
+	return nil
+}
+
+func TestRequestDeleteFirstRequest(t *testing.T) {
+	d := &fakeDeleter{object: &fakeObject{ObjectMeta: metav1.ObjectMeta{UID: types.UID("uid-1")}}}
+	state := &deleteState{}
+
+	outcome, err := requestDelete(context.Background(), d, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outcome != deletePending {
+		t.Fatalf("expected deletePending, got %v", outcome)
+	}
+	if d.annotateCalls != 1 || d.deleteCalls != 1 {
+		t.Fatalf("expected one annotate and one delete call, got annotate=%d delete=%d", d.annotateCalls, d.deleteCalls)
+	}
+	if state.requestedUID != types.UID("uid-1") {
+		t.Fatalf("expected requestedUID to be recorded, got %q", state.requestedUID)
+	}
+}
+
+func TestRequestDeleteAlreadyGone(t *testing.T) {
+	d := &fakeDeleter{object: nil}
+	state := &deleteState{requestedUID: types.UID("uid-1")}
+
+	outcome, err := requestDelete(context.Background(), d, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outcome != deleteComplete {
+		t.Fatalf("expected deleteComplete, got %v", outcome)
+	}
+	if !state.completed {
+		t.Fatalf("expected state.completed to be set")
+	}
+}
+
+func TestRequestDeleteReappearedAfterConfirmedGone(t *testing.T) {
+	// The object was confirmed deleted on a prior Sync (state.completed),
+	// then something else recreated it without our annotation.
+	d := &fakeDeleter{object: &fakeObject{ObjectMeta: metav1.ObjectMeta{UID: types.UID("uid-2")}}}
+	state := &deleteState{requestedUID: types.UID("uid-1"), completed: true}
+
+	outcome, err := requestDelete(context.Background(), d, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outcome != deleteReappeared {
+		t.Fatalf("expected deleteReappeared, got %v", outcome)
+	}
+	if d.annotateCalls != 1 || d.deleteCalls != 1 {
+		t.Fatalf("expected a fresh annotate+delete request on reappearance, got annotate=%d delete=%d", d.annotateCalls, d.deleteCalls)
+	}
+}
+
+func TestRequestDeleteReappearedWithNewUIDBeforeConfirmedGone(t *testing.T) {
+	// Recreated with a different UID before any Sync ever observed the
+	// original gone - still a reappearance, not a first-time request.
+	d := &fakeDeleter{object: &fakeObject{ObjectMeta: metav1.ObjectMeta{UID: types.UID("uid-2")}}}
+	state := &deleteState{requestedUID: types.UID("uid-1")}
+
+	outcome, err := requestDelete(context.Background(), d, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outcome != deleteReappeared {
+		t.Fatalf("expected deleteReappeared, got %v", outcome)
+	}
+}
+
+func TestRequestDeleteStillTerminating(t *testing.T) {
+	now := metav1.Now()
+	d := &fakeDeleter{object: &fakeObject{ObjectMeta: metav1.ObjectMeta{
+		UID:               types.UID("uid-1"),
+		DeletionTimestamp: &now,
+		Annotations:       map[string]string{deleteRequestedAtAnnotation: "2020-01-01T00:00:00Z"},
+	}}}
+	state := &deleteState{requestedUID: types.UID("uid-1")}
+
+	outcome, err := requestDelete(context.Background(), d, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outcome != deletePending {
+		t.Fatalf("expected deletePending while terminating, got %v", outcome)
+	}
+	if d.deleteCalls != 0 {
+		t.Fatalf("expected no redundant delete call while terminating, got %d", d.deleteCalls)
+	}
+}
+
+func TestRequestDeleteAnnotatedButNotYetTerminating(t *testing.T) {
+	// Annotated, same UID we requested, DeletionTimestamp not yet set: the
+	// delete hasn't taken effect, so this must be re-issued but NOT reported
+	// as a reappearance.
+	d := &fakeDeleter{object: &fakeObject{ObjectMeta: metav1.ObjectMeta{
+		UID:         types.UID("uid-1"),
+		Annotations: map[string]string{deleteRequestedAtAnnotation: "2020-01-01T00:00:00Z"},
+	}}}
+	state := &deleteState{requestedUID: types.UID("uid-1")}
+
+	outcome, err := requestDelete(context.Background(), d, state)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outcome != deletePending {
+		t.Fatalf("expected deletePending, got %v", outcome)
+	}
+	if d.deleteCalls != 1 {
+		t.Fatalf("expected the delete to be re-issued, got %d calls", d.deleteCalls)
+	}
+}
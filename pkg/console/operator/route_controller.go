@@ -0,0 +1,108 @@
+package operator
+
+import (
+	"context"
+
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	routev1client "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+	routev1informers "github.com/openshift/client-go/route/informers/externalversions/route/v1"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	"github.com/openshift/console-operator/pkg/api"
+	"github.com/openshift/console-operator/pkg/console/subresource/route"
+)
+
+const routeConditionsPrefix = "Route"
+const routeFieldManager = "console-route-controller"
+
+// ConsoleRouteController reconciles the console Route(s) in
+// openshift-console and owns the Route* status conditions. Splitting this
+// out of the monolithic Sync means an apiserver hiccup updating, say, the
+// OAuth client no longer blocks the route from being reconciled. The route
+// still waits on ConfigSet, so a config that cannot be loaded does block it.
+type ConsoleRouteController struct {
+	operatorClient  v1helpers.OperatorClient
+	configSetGetter *configSetGetter
+	routeClient     routev1client.RoutesGetter
+	deleteState     deleteState
+}
+
+func NewConsoleRouteController(
+	operatorClient v1helpers.OperatorClient,
+	configSetGetter *configSetGetter,
+	routeClient routev1client.RoutesGetter,
+	routes routev1informers.RouteInformer,
+	recorder events.Recorder,
+) (factory.Controller, removableResource) {
+	c := &ConsoleRouteController{
+		operatorClient:  operatorClient,
+		configSetGetter: configSetGetter,
+		routeClient:     routeClient,
+	}
+
+	ctrl := factory.New().
+		WithFilteredEventsInformers(namesFilter(api.OpenShiftConsoleRouteName, api.OpenshiftConsoleCustomRouteName), routes.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsoleRouteController", recorder.WithComponentSuffix("console-route-controller"))
+	return ctrl, c
+}
+
+func (c *ConsoleRouteController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	configs, err := c.configSetGetter.ConfigSet(ctx)
+	if isConfigMissing(err) {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "ConfigMissing", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	actualRoute, _, routeErr := route.ApplyRoutes(ctx, c.routeClient, controllerContext.Recorder(), configs.Operator, configs.Infrastructure)
+	if routeErr != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "RouteApplyFailed", routeErr)
+	}
+
+	return applyStatus(ctx, c.operatorClient, routeFieldManager, operatorStatus.ObservedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType(routeConditionsPrefix+"Available").
+			WithStatus(toConditionStatus(route.IsAdmitted(actualRoute))).
+			WithReason("AsExpected"),
+		applyoperatorv1.OperatorCondition().
+			WithType(routeConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+}
+
+func (c *ConsoleRouteController) degraded(ctx context.Context, observedGeneration int64, reason string, err error) error {
+	return degradedStatus(ctx, c.operatorClient, routeFieldManager, routeConditionsPrefix, observedGeneration, reason, err)
+}
+
+func (c *ConsoleRouteController) tombstone(ctx context.Context) (tombstoneResult, error) {
+	name := route.Stub().Name
+	outcome, err := requestDelete(ctx, funcObjectDeleter{
+		getFn: func(ctx context.Context) (metav1.Object, error) {
+			return c.routeClient.Routes(api.TargetNamespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		annotate_: func(ctx context.Context, key, value string) error {
+			return annotateObject[*routev1.Route](ctx, c.routeClient.Routes(api.TargetNamespace), name, key, value)
+		},
+		deleteFn: func(ctx context.Context) error {
+			return c.routeClient.Routes(api.TargetNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+	}, &c.deleteState)
+	return tombstoneResult{Done: outcome == deleteComplete, Reappeared: outcome == deleteReappeared}, err
+}
@@ -0,0 +1,95 @@
+package operator
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	"github.com/openshift/console-operator/pkg/api"
+	"github.com/openshift/console-operator/pkg/console/subresource/secret"
+)
+
+const secretConditionsPrefix = "Secret"
+const secretFieldManager = "console-secret-controller"
+
+// ConsoleSecretController keeps the console's OAuth client secret in sync
+// and owns the Secret* status conditions.
+type ConsoleSecretController struct {
+	operatorClient v1helpers.OperatorClient
+	secretsClient  coreclientv1.SecretsGetter
+	deleteState    deleteState
+}
+
+func NewConsoleSecretController(
+	operatorClient v1helpers.OperatorClient,
+	secretsClient coreclientv1.SecretsGetter,
+	secrets corev1informers.SecretInformer,
+	recorder events.Recorder,
+) (factory.Controller, removableResource) {
+	c := &ConsoleSecretController{
+		operatorClient: operatorClient,
+		secretsClient:  secretsClient,
+	}
+
+	ctrl := factory.New().
+		WithFilteredEventsInformers(namesFilter(secret.Stub().Name), secrets.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsoleSecretController", recorder.WithComponentSuffix("console-secret-controller"))
+	return ctrl, c
+}
+
+func (c *ConsoleSecretController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	required := secret.DefaultSecret(operatorSpec)
+	if _, _, err := resourceapply.ApplySecret(ctx, c.secretsClient, controllerContext.Recorder(), required); err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "SecretApplyFailed", err)
+	}
+
+	return applyStatus(ctx, c.operatorClient, secretFieldManager, operatorStatus.ObservedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType(secretConditionsPrefix+"Synced").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason("AsExpected"),
+		applyoperatorv1.OperatorCondition().
+			WithType(secretConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+}
+
+func (c *ConsoleSecretController) degraded(ctx context.Context, observedGeneration int64, reason string, err error) error {
+	return degradedStatus(ctx, c.operatorClient, secretFieldManager, secretConditionsPrefix, observedGeneration, reason, err)
+}
+
+func (c *ConsoleSecretController) tombstone(ctx context.Context) (tombstoneResult, error) {
+	name := secret.Stub().Name
+	outcome, err := requestDelete(ctx, funcObjectDeleter{
+		getFn: func(ctx context.Context) (metav1.Object, error) {
+			return c.secretsClient.Secrets(api.TargetNamespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		annotate_: func(ctx context.Context, key, value string) error {
+			return annotateObject[*corev1.Secret](ctx, c.secretsClient.Secrets(api.TargetNamespace), name, key, value)
+		},
+		deleteFn: func(ctx context.Context) error {
+			return c.secretsClient.Secrets(api.TargetNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+	}, &c.deleteState)
+	return tombstoneResult{Done: outcome == deleteComplete, Reappeared: outcome == deleteReappeared}, err
+}
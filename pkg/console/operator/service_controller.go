@@ -0,0 +1,106 @@
+package operator
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	"github.com/openshift/console-operator/pkg/api"
+	"github.com/openshift/console-operator/pkg/console/subresource/service"
+)
+
+const serviceConditionsPrefix = "Service"
+const serviceFieldManager = "console-service-controller"
+
+// ConsoleServiceController keeps the console Service in sync and owns the
+// Service* status conditions.
+type ConsoleServiceController struct {
+	operatorClient  v1helpers.OperatorClient
+	configSetGetter *configSetGetter
+	serviceClient   coreclientv1.ServicesGetter
+	deleteState     deleteState
+}
+
+func NewConsoleServiceController(
+	operatorClient v1helpers.OperatorClient,
+	configSetGetter *configSetGetter,
+	serviceClient coreclientv1.ServicesGetter,
+	services corev1informers.ServiceInformer,
+	recorder events.Recorder,
+) (factory.Controller, removableResource) {
+	c := &ConsoleServiceController{
+		operatorClient:  operatorClient,
+		configSetGetter: configSetGetter,
+		serviceClient:   serviceClient,
+	}
+
+	ctrl := factory.New().
+		WithFilteredEventsInformers(namesFilter(api.OpenShiftConsoleName), services.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsoleServiceController", recorder.WithComponentSuffix("console-service-controller"))
+	return ctrl, c
+}
+
+func (c *ConsoleServiceController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	configs, err := c.configSetGetter.ConfigSet(ctx)
+	if isConfigMissing(err) {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "ConfigMissing", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	required := service.DefaultService(configs.Operator)
+	if _, _, err := resourceapply.ApplyService(ctx, c.serviceClient, controllerContext.Recorder(), required); err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "ServiceApplyFailed", err)
+	}
+
+	return applyStatus(ctx, c.operatorClient, serviceFieldManager, operatorStatus.ObservedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType(serviceConditionsPrefix+"Available").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason("AsExpected"),
+		applyoperatorv1.OperatorCondition().
+			WithType(serviceConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+}
+
+func (c *ConsoleServiceController) degraded(ctx context.Context, observedGeneration int64, reason string, err error) error {
+	return degradedStatus(ctx, c.operatorClient, serviceFieldManager, serviceConditionsPrefix, observedGeneration, reason, err)
+}
+
+func (c *ConsoleServiceController) tombstone(ctx context.Context) (tombstoneResult, error) {
+	name := service.Stub().Name
+	outcome, err := requestDelete(ctx, funcObjectDeleter{
+		getFn: func(ctx context.Context) (metav1.Object, error) {
+			return c.serviceClient.Services(api.TargetNamespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		annotate_: func(ctx context.Context, key, value string) error {
+			return annotateObject[*corev1.Service](ctx, c.serviceClient.Services(api.TargetNamespace), name, key, value)
+		},
+		deleteFn: func(ctx context.Context) error {
+			return c.serviceClient.Services(api.TargetNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+	}, &c.deleteState)
+	return tombstoneResult{Done: outcome == deleteComplete, Reappeared: outcome == deleteReappeared}, err
+}
@@ -0,0 +1,99 @@
+package operator
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	"github.com/openshift/console-operator/pkg/api"
+	"github.com/openshift/console-operator/pkg/console/subresource/configmap"
+)
+
+const serviceCAConfigMapConditionsPrefix = "ServiceCAConfigMap"
+const serviceCAConfigMapFieldManager = "console-serviceca-configmap-controller"
+
+// ConsoleServiceCAConfigMapController mirrors the injected service-CA
+// ConfigMap into the shape the console deployment expects, independent of
+// the user console-config ConfigMap.
+type ConsoleServiceCAConfigMapController struct {
+	operatorClient  v1helpers.OperatorClient
+	configSetGetter *configSetGetter
+	configMapClient coreclientv1.ConfigMapsGetter
+	deleteState     deleteState
+}
+
+func NewConsoleServiceCAConfigMapController(
+	operatorClient v1helpers.OperatorClient,
+	configSetGetter *configSetGetter,
+	configMapClient coreclientv1.ConfigMapsGetter,
+	configMaps corev1informers.ConfigMapInformer,
+	recorder events.Recorder,
+) (factory.Controller, removableResource) {
+	c := &ConsoleServiceCAConfigMapController{
+		operatorClient:  operatorClient,
+		configSetGetter: configSetGetter,
+		configMapClient: configMapClient,
+	}
+
+	ctrl := factory.New().
+		WithFilteredEventsInformers(namesFilter(api.ServiceCAConfigMapName), configMaps.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsoleServiceCAConfigMapController", recorder.WithComponentSuffix("console-serviceca-configmap-controller"))
+	return ctrl, c
+}
+
+func (c *ConsoleServiceCAConfigMapController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	required := configmap.ServiceCAStub()
+	if _, _, err := resourceapply.ApplyConfigMap(ctx, c.configMapClient, controllerContext.Recorder(), required); err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "ServiceCAConfigMapApplyFailed", err)
+	}
+
+	return applyStatus(ctx, c.operatorClient, serviceCAConfigMapFieldManager, operatorStatus.ObservedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType(serviceCAConfigMapConditionsPrefix+"Available").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason("AsExpected"),
+		applyoperatorv1.OperatorCondition().
+			WithType(serviceCAConfigMapConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+}
+
+func (c *ConsoleServiceCAConfigMapController) degraded(ctx context.Context, observedGeneration int64, reason string, err error) error {
+	return degradedStatus(ctx, c.operatorClient, serviceCAConfigMapFieldManager, serviceCAConfigMapConditionsPrefix, observedGeneration, reason, err)
+}
+
+func (c *ConsoleServiceCAConfigMapController) tombstone(ctx context.Context) (tombstoneResult, error) {
+	name := configmap.ServiceCAStub().Name
+	outcome, err := requestDelete(ctx, funcObjectDeleter{
+		getFn: func(ctx context.Context) (metav1.Object, error) {
+			return c.configMapClient.ConfigMaps(api.TargetNamespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		annotate_: func(ctx context.Context, key, value string) error {
+			return annotateObject[*corev1.ConfigMap](ctx, c.configMapClient.ConfigMaps(api.TargetNamespace), name, key, value)
+		},
+		deleteFn: func(ctx context.Context) error {
+			return c.configMapClient.ConfigMaps(api.TargetNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+	}, &c.deleteState)
+	return tombstoneResult{Done: outcome == deleteComplete, Reappeared: outcome == deleteReappeared}, err
+}
@@ -0,0 +1,101 @@
+package operator
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
+	"github.com/openshift/library-go/pkg/operator/events"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+
+	"github.com/openshift/console-operator/pkg/api"
+	"github.com/openshift/console-operator/pkg/console/subresource/configmap"
+)
+
+const trustedCAConfigMapConditionsPrefix = "TrustedCAConfigMap"
+const trustedCAConfigMapFieldManager = "console-trustedca-configmap-controller"
+
+// ConsoleTrustedCAConfigMapController ensures the trust-bundle injection
+// ConfigMap the console deployment mounts for outbound TLS exists, separate
+// from the other console ConfigMaps since it is driven by cluster-wide proxy
+// trust rather than console-specific config.
+type ConsoleTrustedCAConfigMapController struct {
+	operatorClient  v1helpers.OperatorClient
+	configMapClient coreclientv1.ConfigMapsGetter
+	deleteState     deleteState
+}
+
+func NewConsoleTrustedCAConfigMapController(
+	operatorClient v1helpers.OperatorClient,
+	configMapClient coreclientv1.ConfigMapsGetter,
+	configMaps corev1informers.ConfigMapInformer,
+	recorder events.Recorder,
+) (factory.Controller, removableResource) {
+	c := &ConsoleTrustedCAConfigMapController{
+		operatorClient:  operatorClient,
+		configMapClient: configMapClient,
+	}
+
+	ctrl := factory.New().
+		WithFilteredEventsInformers(namesFilter(api.TrustedCAConfigMapName), configMaps.Informer()).
+		WithSync(c.Sync).
+		ToController("ConsoleTrustedCAConfigMapController", recorder.WithComponentSuffix("console-trustedca-configmap-controller"))
+	return ctrl, c
+}
+
+func (c *ConsoleTrustedCAConfigMapController) Sync(ctx context.Context, controllerContext factory.SyncContext) error {
+	operatorSpec, operatorStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil {
+		return err
+	}
+	if operatorSpec.ManagementState != operatorsv1.Managed {
+		return nil
+	}
+
+	required := configmap.TrustedCAStub()
+	if _, _, err := resourceapply.ApplyConfigMap(ctx, c.configMapClient, controllerContext.Recorder(), required); err != nil {
+		return c.degraded(ctx, operatorStatus.ObservedGeneration, "TrustedCAConfigMapApplyFailed", err)
+	}
+
+	return applyStatus(ctx, c.operatorClient, trustedCAConfigMapFieldManager, operatorStatus.ObservedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType(trustedCAConfigMapConditionsPrefix+"Available").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason("AsExpected"),
+		applyoperatorv1.OperatorCondition().
+			WithType(trustedCAConfigMapConditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionFalse).
+			WithReason("AsExpected"),
+	)
+}
+
+func (c *ConsoleTrustedCAConfigMapController) degraded(ctx context.Context, observedGeneration int64, reason string, err error) error {
+	return degradedStatus(ctx, c.operatorClient, trustedCAConfigMapFieldManager, trustedCAConfigMapConditionsPrefix, observedGeneration, reason, err)
+}
+
+// tombstone deletes the trusted-CA ConfigMap as part of Removed state
+// teardown, using the same annotate-then-delete protocol as every other
+// console-managed object so a reappearance (e.g. the cluster network
+// operator recreating it) is reported rather than silently re-deleted.
+func (c *ConsoleTrustedCAConfigMapController) tombstone(ctx context.Context) (tombstoneResult, error) {
+	name := configmap.TrustedCAStub().Name
+	outcome, err := requestDelete(ctx, funcObjectDeleter{
+		getFn: func(ctx context.Context) (metav1.Object, error) {
+			return c.configMapClient.ConfigMaps(api.TargetNamespace).Get(ctx, name, metav1.GetOptions{})
+		},
+		annotate_: func(ctx context.Context, key, value string) error {
+			return annotateObject[*corev1.ConfigMap](ctx, c.configMapClient.ConfigMaps(api.TargetNamespace), name, key, value)
+		},
+		deleteFn: func(ctx context.Context) error {
+			return c.configMapClient.ConfigMaps(api.TargetNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+	}, &c.deleteState)
+	return tombstoneResult{Done: outcome == deleteComplete, Reappeared: outcome == deleteReappeared}, err
+}
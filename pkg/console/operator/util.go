@@ -0,0 +1,61 @@
+package operator
+
+import (
+	"context"
+
+	operatorsv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+)
+
+// toConditionStatus is a small convenience shared by the per-resource
+// controllers when translating a boolean readiness check into the
+// ConditionStatus expected on operatorsv1.OperatorCondition.
+func toConditionStatus(b bool) operatorsv1.ConditionStatus {
+	if b {
+		return operatorsv1.ConditionTrue
+	}
+	return operatorsv1.ConditionFalse
+}
+
+// applyStatus server-side-applies the given conditions and observedGeneration
+// onto the Console operator status via the generic operatorClient, scoped to
+// fieldManager. Each per-resource controller passes its own field manager
+// name so that concurrent controllers each own a narrow, non-overlapping
+// slice of status instead of racing on a DeepCopy+Update of the whole
+// object.
+func applyStatus(
+	ctx context.Context,
+	operatorClient v1helpers.OperatorClient,
+	fieldManager string,
+	observedGeneration int64,
+	conditions ...*applyoperatorv1.OperatorConditionApplyConfiguration,
+) error {
+	status := applyoperatorv1.OperatorStatus().
+		WithObservedGeneration(observedGeneration).
+		WithConditions(conditions...)
+	return operatorClient.ApplyOperatorStatus(ctx, fieldManager, status)
+}
+
+// degradedStatus applies a single Degraded=True condition under
+// conditionsPrefix, the sequence every per-resource controller's degraded
+// method otherwise repeats verbatim aside from its own prefix and field
+// manager.
+func degradedStatus(
+	ctx context.Context,
+	operatorClient v1helpers.OperatorClient,
+	fieldManager string,
+	conditionsPrefix string,
+	observedGeneration int64,
+	reason string,
+	err error,
+) error {
+	_ = applyStatus(ctx, operatorClient, fieldManager, observedGeneration,
+		applyoperatorv1.OperatorCondition().
+			WithType(conditionsPrefix+"Degraded").
+			WithStatus(operatorsv1.ConditionTrue).
+			WithReason(reason).
+			WithMessage(err.Error()),
+	)
+	return err
+}